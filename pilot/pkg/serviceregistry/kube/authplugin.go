@@ -0,0 +1,90 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// RegisterAuthProvider exposes client-go's auth provider registration so
+// downstream binaries can opt in to additional cluster authentication
+// plugins (e.g. azure, openstack) without this package having to hard-import
+// every one of them. The build-tagged subpackages kube/authplugins/azure and
+// kube/authplugins/openstack do not call this: they import client-go's own
+// plugin packages, which register themselves with client-go on init().
+//
+// Exec credential plugins (aws-iam-authenticator, gke-gcloud-auth-plugin,
+// and the like) don't go through this hook: they're implemented in
+// client-go core, not registered as an AuthProvider, so there's no
+// side-effect import to opt in to. Use CreateInterfaceWithExecCredential
+// with an ExecCredentialConfig instead.
+func RegisterAuthProvider(name string, factory rest.Factory) error {
+	return rest.RegisterAuthProviderPlugin(name, factory)
+}
+
+// ExecCredentialConfig configures a client.authentication.k8s.io exec
+// credential plugin (e.g. aws-iam-authenticator, gke-gcloud-auth-plugin) to
+// be invoked for every request against the apiserver. This is needed now
+// that client-go is dropping its in-tree cloud-provider auth plugins in
+// favor of the exec credential protocol.
+// There is deliberately no Timeout field here: clientcmdapi.ExecConfig has no
+// timeout of its own, so a Timeout on this struct would be a knob that
+// silently did nothing. Use WithTimeout (via CreateInterfaceWithOptions) to
+// bound the overall request instead.
+type ExecCredentialConfig struct {
+	// Command is the exec plugin binary to run.
+	Command string
+	// Args are passed to Command.
+	Args []string
+	// Env are additional environment variables passed to Command, on top of
+	// the parent process's environment.
+	Env map[string]string
+}
+
+// execCredentialAPIVersion is the client.authentication.k8s.io version
+// understood by the exec credential plugins we support (aws-iam-authenticator,
+// gke-gcloud-auth-plugin).
+const execCredentialAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+// apply wires the ExecCredentialConfig into a rest.Config's ExecProvider.
+func (e *ExecCredentialConfig) apply(config *rest.Config) {
+	if e == nil {
+		return
+	}
+	envVars := make([]clientcmdapi.ExecEnvVar, 0, len(e.Env))
+	for k, v := range e.Env {
+		envVars = append(envVars, clientcmdapi.ExecEnvVar{Name: k, Value: v})
+	}
+	config.ExecProvider = &clientcmdapi.ExecConfig{
+		Command:    e.Command,
+		Args:       e.Args,
+		Env:        envVars,
+		APIVersion: execCredentialAPIVersion,
+	}
+}
+
+// CreateInterfaceWithExecCredential is a helper function to create a
+// Kubernetes interface from a kubeconfig file, additionally configuring an
+// exec credential plugin on the resulting rest.Config. Unlike the
+// AuthProvider hooks registered via RegisterAuthProvider, exec credential
+// plugins are part of client-go core and don't need a plugin import or a
+// build tag of their own: a binary just needs to call this with the desired
+// ExecCredentialConfig. It builds on CreateInterfaceWithOptions so the
+// clientset is only built once.
+func CreateInterfaceWithExecCredential(kubeconfig string, execConfig *ExecCredentialConfig) (*rest.Config, kubernetes.Interface, error) {
+	return CreateInterfaceWithOptions(kubeconfig, execConfig.apply)
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build authprovider_azure
+// +build authprovider_azure
+
+// Package azure registers the Azure Active Directory cluster authentication
+// plugin with client-go, where the kube package's clients pick it up. It is
+// excluded from default builds by the
+// authprovider_azure build tag; binaries that need AKS support should import
+// it for its side effect:
+//
+//	import _ "istio.io/istio/pilot/pkg/serviceregistry/kube/authplugins/azure"
+package azure
+
+import (
+	// import Azure cluster authentication plugin
+	_ "k8s.io/client-go/plugin/pkg/client/auth/azure"
+)
@@ -0,0 +1,69 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ResolveConfigFromBytes parses an in-memory kubeconfig, mirroring
+// ResolveConfig for callers that receive a kubeconfig over the wire (e.g.
+// from a Kubernetes Secret in the multi-cluster secret controller, or from a
+// config API) instead of reading one from disk.
+func ResolveConfigFromBytes(kubeconfig []byte) (*clientcmdapi.Config, error) {
+	return clientcmd.Load(kubeconfig)
+}
+
+// CreateInterfaceFromBytes is a helper function to create a Kubernetes
+// interface from an in-memory kubeconfig, so callers don't have to write it
+// to a tempfile first. It shares createInterface with CreateInterface, so
+// validation, context selection, and auth-plugin resolution are identical.
+func CreateInterfaceFromBytes(kubeconfig []byte) (*rest.Config, kubernetes.Interface, error) {
+	clusterConfig, err := ResolveConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return createInterface(clusterConfig)
+}
+
+// CreateInterfaceFromBytesUntrusted is like CreateInterfaceFromBytes, but
+// first strips exec, AuthProvider, and TokenFile fields from every AuthInfo
+// in the kubeconfig. This matches the safety model needed when loading
+// kubeconfigs supplied by remote clusters (e.g. multi-cluster remote
+// secrets): an untrusted kubeconfig cannot make our process execute commands
+// or read a local token file. Client certificate, client key, and
+// certificate-authority file paths are not stripped and are still read.
+func CreateInterfaceFromBytesUntrusted(kubeconfig []byte) (*rest.Config, kubernetes.Interface, error) {
+	clusterConfig, err := ResolveConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	sanitizeUntrusted(clusterConfig)
+	return createInterface(clusterConfig)
+}
+
+// sanitizeUntrusted strips exec plugins, AuthProvider plugins, and TokenFile
+// from every AuthInfo of a kubeconfig. File paths for client certificates,
+// client keys, and certificate authorities are left in place.
+func sanitizeUntrusted(clusterConfig *clientcmdapi.Config) {
+	for _, authInfo := range clusterConfig.AuthInfos {
+		authInfo.Exec = nil
+		authInfo.AuthProvider = nil
+		authInfo.TokenFile = ""
+	}
+}
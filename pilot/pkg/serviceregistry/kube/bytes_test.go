@@ -0,0 +1,47 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"testing"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestSanitizeUntrustedStripsExecAuthProviderAndTokenFile(t *testing.T) {
+	clusterConfig := clientcmdapi.NewConfig()
+	clusterConfig.AuthInfos["remote"] = &clientcmdapi.AuthInfo{
+		Exec:         &clientcmdapi.ExecConfig{Command: "aws-iam-authenticator"},
+		AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "gcp"},
+		TokenFile:    "/var/run/secrets/token",
+		Token:        "keep-me",
+	}
+
+	sanitizeUntrusted(clusterConfig)
+
+	authInfo := clusterConfig.AuthInfos["remote"]
+	if authInfo.Exec != nil {
+		t.Errorf("expected Exec to be stripped, got %+v", authInfo.Exec)
+	}
+	if authInfo.AuthProvider != nil {
+		t.Errorf("expected AuthProvider to be stripped, got %+v", authInfo.AuthProvider)
+	}
+	if authInfo.TokenFile != "" {
+		t.Errorf("expected TokenFile to be stripped, got %q", authInfo.TokenFile)
+	}
+	if authInfo.Token != "keep-me" {
+		t.Errorf("expected unrelated fields like Token to be left alone, got %q", authInfo.Token)
+	}
+}
@@ -31,6 +31,9 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	// import OIDC cluster authentication plugin, e.g. for Tectonic
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
+	// additional cluster authentication plugins (azure, openstack, ...) are
+	// not hard-imported here; see RegisterAuthProvider and
+	// kube/authplugins for how to opt in to them from a binary's main.
 )
 
 // ResolveConfig checks whether to use the in-cluster or out-of-cluster config
@@ -0,0 +1,146 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"istio.io/istio/pkg/cluster"
+)
+
+// ClusterClient bundles the rest.Config and kubernetes.Interface built for a
+// single kubeconfig context so callers fanning out across contexts don't have
+// to keep the two in sync themselves.
+type ClusterClient struct {
+	Config *rest.Config
+	Client kubernetes.Interface
+}
+
+// clientCacheKey identifies a cached ClusterClient. Context names are not
+// unique across kubeconfigs (kubeadm, minikube, etc. all default to the same
+// context name), so the cache must be keyed by kubeconfig path as well as
+// context name, never by context name alone.
+type clientCacheKey struct {
+	kubeconfig  string
+	contextName string
+}
+
+// clientCache memoizes the ClusterClient built for a given (kubeconfig,
+// context) pair so repeated lookups don't re-parse the file or re-dial the
+// apiserver. Entries are never invalidated or expired: if the kubeconfig is
+// rewritten with rotated credentials, callers holding a cached entry keep
+// using the stale client until the process restarts. Use WatchingClient
+// instead if you need rotated credentials to be picked up automatically.
+var clientCache = struct {
+	sync.RWMutex
+	byKey map[clientCacheKey]*ClusterClient
+}{byKey: make(map[clientCacheKey]*ClusterClient)}
+
+func cachedClusterClient(key clientCacheKey) (*ClusterClient, bool) {
+	clientCache.RLock()
+	defer clientCache.RUnlock()
+	c, ok := clientCache.byKey[key]
+	return c, ok
+}
+
+func storeClusterClient(key clientCacheKey, c *ClusterClient) {
+	clientCache.Lock()
+	defer clientCache.Unlock()
+	clientCache.byKey[key] = c
+}
+
+// CreateInterfaceForContext is a helper function to create a Kubernetes
+// interface for a single named context within a (possibly multi-cluster)
+// kubeconfig file. Results are cached by (kubeconfig path, context name) so
+// that repeated calls with the same kubeconfig and context don't re-parse
+// the file or re-dial the apiserver.
+func CreateInterfaceForContext(kubeconfig, contextName string) (*rest.Config, kubernetes.Interface, error) {
+	key := clientCacheKey{kubeconfig: kubeconfig, contextName: contextName}
+	if c, ok := cachedClusterClient(key); ok {
+		return c.Config, c.Client, nil
+	}
+
+	clusterConfig, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restConfig, client, err := createInterfaceForContext(clusterConfig, contextName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeClusterClient(key, &ClusterClient{Config: restConfig, Client: client})
+	return restConfig, client, nil
+}
+
+// CreateInterfacesForAllContexts parses a kubeconfig containing one or more
+// clusters/contexts and returns a ClusterClient for every context it
+// defines, keyed by cluster.ID (the context name). This mirrors the
+// multi-cluster remote-secret loading in pkg/kube/multicluster, but as a
+// reusable helper for tools and controllers that want to fan out across
+// contexts without writing their own loader.
+//
+// A context that fails to build a client does not abort the whole call: its
+// error is accumulated and returned alongside a map containing every context
+// that succeeded, so one misconfigured cluster doesn't take down access to
+// the rest.
+func CreateInterfacesForAllContexts(kubeconfig string) (map[cluster.ID]*ClusterClient, error) {
+	clusterConfig, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs *multierror.Error
+	out := make(map[cluster.ID]*ClusterClient, len(clusterConfig.Contexts))
+	for contextName := range clusterConfig.Contexts {
+		restConfig, client, err := createInterfaceForContext(clusterConfig, contextName)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to build client for context %q: %v", contextName, err))
+			continue
+		}
+		cc := &ClusterClient{Config: restConfig, Client: client}
+		storeClusterClient(clientCacheKey{kubeconfig: kubeconfig, contextName: contextName}, cc)
+		out[cluster.ID(contextName)] = cc
+	}
+	return out, errs.ErrorOrNil()
+}
+
+// createInterfaceForContext builds a rest.Config and kubernetes.Interface
+// for a single context of an already-loaded clientcmdapi.Config, overriding
+// the current context so the right cluster/user/namespace combination is
+// selected.
+func createInterfaceForContext(clusterConfig *clientcmdapi.Config, contextName string) (*rest.Config, kubernetes.Interface, error) {
+	if _, ok := clusterConfig.Contexts[contextName]; !ok {
+		return nil, nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+	clientConfig := clientcmd.NewNonInteractiveClientConfig(*clusterConfig, contextName, &clientcmd.ConfigOverrides{}, nil)
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return restConfig, client, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import "testing"
+
+func TestClientCacheKeyedByKubeconfigAndContext(t *testing.T) {
+	const contextName = "kubernetes-admin@kubernetes"
+	keyA := clientCacheKey{kubeconfig: "/a/config", contextName: contextName}
+	keyB := clientCacheKey{kubeconfig: "/b/config", contextName: contextName}
+
+	if keyA == keyB {
+		t.Fatalf("cache keys for different kubeconfigs with the same context name must differ: %v == %v", keyA, keyB)
+	}
+
+	clientA := &ClusterClient{}
+	storeClusterClient(keyA, clientA)
+
+	if _, ok := cachedClusterClient(keyB); ok {
+		t.Fatalf("expected no cache hit for %v after only storing %v", keyB, keyA)
+	}
+	got, ok := cachedClusterClient(keyA)
+	if !ok || got != clientA {
+		t.Fatalf("expected cache hit for %v to return the stored client, got %v, %v", keyA, got, ok)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+// applyOptions mirrors the loop in CreateInterfaceWithOptions, applying each
+// Option to config in order.
+func applyOptions(config *rest.Config, opts ...Option) {
+	for _, opt := range opts {
+		opt(config)
+	}
+}
+
+func TestOptionsApplyInOrder(t *testing.T) {
+	config := &rest.Config{}
+	applyOptions(config,
+		WithUserAgent("first"),
+		WithUserAgent("second"),
+	)
+	if config.UserAgent != "second" {
+		t.Fatalf("expected the later option to win, got UserAgent=%q", config.UserAgent)
+	}
+}
+
+func TestWithWrapTransportChainsOntoExisting(t *testing.T) {
+	var calls []string
+	config := &rest.Config{
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			calls = append(calls, "from-kubeconfig")
+			return rt
+		},
+	}
+	applyOptions(config, WithWrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+		calls = append(calls, "added-by-option")
+		return rt
+	}))
+
+	config.WrapTransport(http.DefaultTransport)
+	if len(calls) != 2 || calls[0] != "from-kubeconfig" || calls[1] != "added-by-option" {
+		t.Fatalf("expected the pre-existing WrapTransport to run before the new one, got %v", calls)
+	}
+}
+
+func TestWithInsecureSkipVerifyClearsCAData(t *testing.T) {
+	config := &rest.Config{}
+	config.TLSClientConfig.CAData = []byte("ca-bytes")
+	config.TLSClientConfig.CAFile = "/etc/ca.crt"
+	config.TLSClientConfig.ServerName = "apiserver.example.com"
+
+	applyOptions(config, WithInsecureSkipVerify(true))
+
+	if !config.TLSClientConfig.Insecure {
+		t.Fatalf("expected Insecure to be set")
+	}
+	if config.TLSClientConfig.CAData != nil || config.TLSClientConfig.CAFile != "" || config.TLSClientConfig.ServerName != "" {
+		t.Fatalf("expected CA data/file/server name to be cleared when forcing insecure, got %+v", config.TLSClientConfig)
+	}
+}
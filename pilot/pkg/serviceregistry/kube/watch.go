@@ -0,0 +1,206 @@
+// Copyright 2026 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"istio.io/istio/pkg/log"
+)
+
+// WatchingClient wraps a kubernetes.Interface built from a kubeconfig file
+// and keeps it up to date as the file (or any certificate/key file it
+// references) changes on disk, so long-running controllers pick up rotated
+// credentials without restarting. Exec credential plugin output (see
+// ExecCredentialConfig) is re-invoked on every request by client-go itself
+// and isn't a static file, so there's nothing for this watcher to track
+// there.
+type WatchingClient struct {
+	kubeconfig string
+	opts       []Option
+
+	mu     sync.RWMutex
+	config *rest.Config
+	client kubernetes.Interface
+
+	watcher  *fsnotify.Watcher
+	reloadCh chan error
+	done     chan struct{}
+}
+
+// NewWatchingClient builds the initial kubernetes.Interface via
+// CreateInterfaceWithOptions and starts watching the directories containing
+// the kubeconfig file and any certificate-authority, client-certificate, or
+// client-key files it references, for changes.
+func NewWatchingClient(kubeconfig string, opts ...Option) (*WatchingClient, error) {
+	config, client, err := CreateInterfaceWithOptions(kubeconfig, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &WatchingClient{
+		kubeconfig: kubeconfig,
+		opts:       opts,
+		config:     config,
+		client:     client,
+		watcher:    watcher,
+		reloadCh:   make(chan error, 1),
+		done:       make(chan struct{}),
+	}
+
+	if err := w.addWatches(); err != nil {
+		watcher.Close() // nolint: errcheck
+		return nil, err
+	}
+
+	go w.run()
+	return w, nil
+}
+
+// addWatches registers the *directories* containing the kubeconfig and any
+// files it references for client certificates/keys or a CA bundle with the
+// fsnotify watcher. Watching directories rather than the leaf files
+// themselves is the established Istio pattern: Kubernetes secret and
+// projected-token volume mounts (and most cert rotators) rotate their
+// contents by atomically swapping a "..data" symlink rather than writing the
+// leaf file in place, which replaces the leaf file's inode and leaves an
+// fsnotify watch on it stale with no further events. A directory watch keeps
+// observing the swap regardless of which inode the leaf file currently
+// points to.
+func (w *WatchingClient) addWatches() error {
+	dirs := map[string]struct{}{filepath.Dir(w.kubeconfig): {}}
+
+	clusterConfig, err := clientcmd.LoadFromFile(w.kubeconfig)
+	if err == nil {
+		for _, authInfo := range clusterConfig.AuthInfos {
+			for _, f := range []string{authInfo.ClientCertificate, authInfo.ClientKey} {
+				if f != "" {
+					dirs[filepath.Dir(f)] = struct{}{}
+				}
+			}
+		}
+		for _, cluster := range clusterConfig.Clusters {
+			if cluster.CertificateAuthority != "" {
+				dirs[filepath.Dir(cluster.CertificateAuthority)] = struct{}{}
+			}
+		}
+	}
+	// If the kubeconfig failed to parse, we still watch its directory;
+	// referenced files will be picked up on the next successful reload.
+
+	for dir := range dirs {
+		if err := w.watcher.Add(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run watches for fsnotify events and reloads the client on every change,
+// reporting the outcome on OnReload().
+func (w *WatchingClient) run() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+			w.reload()
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Errorf("kubeconfig watcher error: %v", err)
+		}
+	}
+}
+
+// reload rebuilds the rest.Config and kubernetes.Interface from the
+// kubeconfig and atomically swaps them in, then re-runs addWatches so the
+// directories of any files the new kubeconfig references are watched too,
+// and notifies OnReload().
+func (w *WatchingClient) reload() {
+	config, client, err := CreateInterfaceWithOptions(w.kubeconfig, w.opts...)
+	if err != nil {
+		w.notifyReload(err)
+		return
+	}
+
+	w.mu.Lock()
+	w.config = config
+	w.client = client
+	w.mu.Unlock()
+
+	if err := w.addWatches(); err != nil {
+		log.Errorf("failed to re-establish kubeconfig watches: %v", err)
+	}
+	w.notifyReload(nil)
+}
+
+func (w *WatchingClient) notifyReload(err error) {
+	select {
+	case w.reloadCh <- err:
+	default:
+		// Drop the notification if nobody is listening; callers only care
+		// about the latest client, which is always available via
+		// RESTConfig()/Client().
+	}
+}
+
+// OnReload returns a channel that receives a value, nil on success or a
+// non-nil error on failure, when a change in a watched directory triggers a
+// reload. The channel holds at most one pending value; the outcome of a
+// reload that finds it full is dropped.
+func (w *WatchingClient) OnReload() <-chan error {
+	return w.reloadCh
+}
+
+// RESTConfig returns the current rest.Config, reflecting the most recent
+// successful reload.
+func (w *WatchingClient) RESTConfig() *rest.Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.config
+}
+
+// Client returns the current kubernetes.Interface, reflecting the most
+// recent successful reload.
+func (w *WatchingClient) Client() kubernetes.Interface {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.client
+}
+
+// Close stops watching the kubeconfig and releases the fsnotify watcher.
+func (w *WatchingClient) Close() error {
+	close(w.done)
+	return w.watcher.Close()
+}